@@ -0,0 +1,140 @@
+package ollamaclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ChatMessage represents a single message in a chat conversation
+type ChatMessage struct {
+	Role    string `json:"role"` // "system", "user", "assistant" or "tool"
+	Content string `json:"content"`
+}
+
+// ChatRequest represents the request payload for the chat API call
+type ChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []ChatMessage   `json:"messages"`
+	Options  map[string]any  `json:"options,omitempty"`
+	Format   json.RawMessage `json:"format,omitempty"` // either the string "json" or a JSON schema
+	Tools    json.RawMessage `json:"tools,omitempty"`
+	Stream   bool            `json:"stream,omitempty"`
+}
+
+// ChatResponse represents the response data from the chat API call
+type ChatResponse struct {
+	Model              string      `json:"model"`
+	CreatedAt          string      `json:"created_at"`
+	Message            ChatMessage `json:"message"`
+	Done               bool        `json:"done"`
+	TotalDuration      int64       `json:"total_duration,omitempty"`
+	LoadDuration       int64       `json:"load_duration,omitempty"`
+	PromptEvalCount    int         `json:"prompt_eval_count,omitempty"`
+	PromptEvalDuration int64       `json:"prompt_eval_duration,omitempty"`
+	EvalCount          int         `json:"eval_count,omitempty"`
+	EvalDuration       int64       `json:"eval_duration,omitempty"`
+}
+
+// Chat sends a chat request to the Ollama API and returns the final response.
+// The request is always sent with streaming on (req.Stream is ignored): the
+// individual chunks are collected and the final response will have its
+// Message.Content set to the concatenation of all of the streamed chunks.
+func (oc *Config) Chat(ctx context.Context, req ChatRequest) (ChatResponse, error) {
+	var final ChatResponse
+	var sb []byte
+	err := oc.ChatStream(ctx, req, func(chunk ChatResponse) error {
+		sb = append(sb, chunk.Message.Content...)
+		final = chunk
+		return nil
+	})
+	if err != nil {
+		return ChatResponse{}, err
+	}
+	final.Message.Content = string(sb)
+	return final, nil
+}
+
+// ChatStream sends a chat request to the Ollama API and invokes fn once per
+// streamed NDJSON chunk as it arrives. It honors ctx cancellation so callers
+// can abort mid-stream.
+func (oc *Config) ChatStream(ctx context.Context, req ChatRequest, fn func(ChatResponse) error) error {
+	if req.Model == "" {
+		req.Model = oc.Model
+	}
+	req.Stream = true
+
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return oc.chatRaw(ctx, reqBytes, func(chunk chatRawResponse) error {
+		return fn(ChatResponse{
+			Model:              chunk.Model,
+			CreatedAt:          chunk.CreatedAt,
+			Message:            chunk.Message.ChatMessage,
+			Done:               chunk.Done,
+			TotalDuration:      chunk.TotalDuration,
+			LoadDuration:       chunk.LoadDuration,
+			PromptEvalCount:    chunk.PromptEvalCount,
+			PromptEvalDuration: chunk.PromptEvalDuration,
+			EvalCount:          chunk.EvalCount,
+			EvalDuration:       chunk.EvalDuration,
+		})
+	})
+}
+
+// chatRawResponse is like ChatResponse but also decodes the tool_calls the
+// server attaches to an assistant message, which plain ChatResponse ignores
+type chatRawResponse struct {
+	Model              string          `json:"model"`
+	CreatedAt          string          `json:"created_at"`
+	Message            toolChatMessage `json:"message"`
+	Done               bool            `json:"done"`
+	TotalDuration      int64           `json:"total_duration,omitempty"`
+	LoadDuration       int64           `json:"load_duration,omitempty"`
+	PromptEvalCount    int             `json:"prompt_eval_count,omitempty"`
+	PromptEvalDuration int64           `json:"prompt_eval_duration,omitempty"`
+	EvalCount          int             `json:"eval_count,omitempty"`
+	EvalDuration       int64           `json:"eval_duration,omitempty"`
+}
+
+// chatRaw posts an already-marshaled /api/chat request body and decodes the
+// NDJSON response stream into chatRawResponse, which is a superset of
+// ChatResponse used internally by tool-call dispatch.
+func (oc *Config) chatRaw(ctx context.Context, reqBytes []byte, fn func(chatRawResponse) error) error {
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/chat: %s\n", oc.API, string(reqBytes))
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, oc.API+"/api/chat", bytes.NewBuffer(reqBytes))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var chunk chatRawResponse
+		if err := decoder.Decode(&chunk); err != nil {
+			return err
+		}
+		if err := fn(chunk); err != nil {
+			return err
+		}
+		if chunk.Done {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package ollamaclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateRequest represents the request payload for the create API call
+type CreateRequest struct {
+	Name      string `json:"name"`
+	Modelfile string `json:"modelfile"`
+	Stream    bool   `json:"stream,omitempty"`
+}
+
+// CopyRequest represents the request payload for the copy API call
+type CopyRequest struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+}
+
+// DeleteRequest represents the request payload for the delete API call
+type DeleteRequest struct {
+	Name string `json:"name"`
+}
+
+// PushRequest represents the request payload for the push API call
+type PushRequest struct {
+	Name     string `json:"name"`
+	Insecure bool   `json:"insecure,omitempty"`
+	Stream   bool   `json:"stream,omitempty"`
+}
+
+// Create builds a model from the given Modelfile contents, under the given name
+func (oc *Config) Create(name, modelfile string) error {
+	return oc.CreateContext(context.Background(), name, modelfile)
+}
+
+// CreateContext is like Create, but takes a context.Context so the caller
+// can cancel the request or set a deadline. It is a thin wrapper around
+// CreateStream that discards progress events, keeping only the final error.
+func (oc *Config) CreateContext(ctx context.Context, name, modelfile string) error {
+	return oc.CreateStream(ctx, CreateRequest{Name: name, Modelfile: modelfile}, func(PullEvent) error {
+		return nil
+	})
+}
+
+// CreateStream sends a create request and invokes fn once per streamed
+// NDJSON status update, as a typed PullEvent, the same way PullStream does
+// for /api/pull — so a UI can render a shared progress bar for both.
+func (oc *Config) CreateStream(ctx context.Context, req CreateRequest, fn func(PullEvent) error) error {
+	req.Stream = true
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/create: %s\n", oc.API, string(reqBytes))
+	}
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, oc.API+"/api/create", reqBytes)
+	if err != nil {
+		return err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("create %s failed: %s", req.Name, resp.Status)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var status PullResponse
+		if err := decoder.Decode(&status); err != nil {
+			return err
+		}
+		if err := fn(PullEvent{
+			Phase:     pullPhase(status),
+			Digest:    status.Digest,
+			Completed: status.Completed,
+			Total:     status.Total,
+			Status:    status.Status,
+		}); err != nil {
+			return err
+		}
+		if status.Status == "success" {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// Copy duplicates an existing model under a new name
+func (oc *Config) Copy(source, destination string) error {
+	return oc.CopyContext(context.Background(), source, destination)
+}
+
+// CopyContext is like Copy, but takes a context.Context so the caller can
+// cancel the request or set a deadline.
+func (oc *Config) CopyContext(ctx context.Context, source, destination string) error {
+	reqBytes, err := json.Marshal(CopyRequest{Source: source, Destination: destination})
+	if err != nil {
+		return err
+	}
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/copy: %s\n", oc.API, string(reqBytes))
+	}
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, oc.API+"/api/copy", reqBytes)
+	if err != nil {
+		return err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("copy %s to %s failed: %s", source, destination, resp.Status)
+	}
+	return nil
+}
+
+// Delete removes a model from the server's model store
+func (oc *Config) Delete(name string) error {
+	return oc.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is like Delete, but takes a context.Context so the caller
+// can cancel the request or set a deadline.
+func (oc *Config) DeleteContext(ctx context.Context, name string) error {
+	reqBytes, err := json.Marshal(DeleteRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/delete: %s\n", oc.API, string(reqBytes))
+	}
+	httpReq, err := newJSONRequest(ctx, http.MethodDelete, oc.API+"/api/delete", reqBytes)
+	if err != nil {
+		return err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("delete %s failed: %s", name, resp.Status)
+	}
+	return nil
+}
+
+// PushEvent is a progress update emitted by Push, in the same classified
+// PullEvent shape PullStream/CreateStream use, plus Err so a stream-time
+// failure (such as a decode error) can be surfaced to the caller rather
+// than silently closing the channel. A single progress renderer can be
+// shared across Pull, Create and Push.
+type PushEvent struct {
+	PullEvent
+	Err error
+}
+
+// Push uploads a model to a model library, streaming progress updates on
+// the returned channel in the same shape as Pull's progress events. The
+// channel is closed once the push completes or fails; a failure is sent
+// as a final PushEvent with Err set before the channel closes. Once the
+// context is canceled, the channel may be closed without such an event.
+func (oc *Config) Push(name string, insecure bool) (<-chan PushEvent, error) {
+	return oc.PushContext(context.Background(), name, insecure)
+}
+
+// PushContext is like Push, but takes a context.Context so the caller can
+// cancel the upload or set a deadline.
+func (oc *Config) PushContext(ctx context.Context, name string, insecure bool) (<-chan PushEvent, error) {
+	reqBytes, err := json.Marshal(PushRequest{Name: name, Insecure: insecure, Stream: true})
+	if err != nil {
+		return nil, err
+	}
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/push: %s\n", oc.API, string(reqBytes))
+	}
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, oc.API+"/api/push", reqBytes)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("push %s failed: %s", name, resp.Status)
+	}
+
+	progress := make(chan PushEvent)
+	go func() {
+		defer resp.Body.Close()
+		defer close(progress)
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var status PullResponse
+			if err := decoder.Decode(&status); err != nil {
+				select {
+				case progress <- PushEvent{Err: fmt.Errorf("push %s: reading progress: %w", name, err)}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			select {
+			case progress <- PushEvent{PullEvent: PullEvent{
+				Phase:     pullPhase(status),
+				Digest:    status.Digest,
+				Completed: status.Completed,
+				Total:     status.Total,
+				Status:    status.Status,
+			}}:
+			case <-ctx.Done():
+				return
+			}
+			if status.Status == "success" {
+				return
+			}
+		}
+	}()
+	return progress, nil
+}
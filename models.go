@@ -0,0 +1,95 @@
+package ollamaclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RunningModel represents a model that is currently loaded into memory, as
+// reported by /api/ps
+type RunningModel struct {
+	Name      string       `json:"name"`
+	Digest    string       `json:"digest"`
+	Size      int64        `json:"size"`
+	SizeVRAM  int64        `json:"size_vram"`
+	ExpiresAt string       `json:"expires_at"`
+	Details   ModelDetails `json:"details,omitempty"`
+}
+
+// runningModelsResponse represents the response data from the ps API call
+type runningModelsResponse struct {
+	Models []RunningModel `json:"models"`
+}
+
+// ShowRequest represents the request payload for the show API call
+type ShowRequest struct {
+	Name string `json:"name"`
+}
+
+// ShowResponse represents the response data from the show API call
+type ShowResponse struct {
+	Modelfile  string       `json:"modelfile"`
+	Parameters string       `json:"parameters"`
+	Template   string       `json:"template"`
+	Details    ModelDetails `json:"details"`
+}
+
+// RunningModels returns the models that are currently loaded into memory
+func (oc *Config) RunningModels() ([]RunningModel, error) {
+	return oc.RunningModelsContext(context.Background())
+}
+
+// RunningModelsContext is like RunningModels, but takes a context.Context so
+// the caller can cancel the request or set a deadline.
+func (oc *Config) RunningModelsContext(ctx context.Context) ([]RunningModel, error) {
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/ps\n", oc.API)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, oc.API+"/api/ps", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var psResp runningModelsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&psResp); err != nil {
+		return nil, err
+	}
+	return psResp.Models, nil
+}
+
+// Show returns the Modelfile, template, parameters and details for the given model
+func (oc *Config) Show(model string) (ShowResponse, error) {
+	return oc.ShowContext(context.Background(), model)
+}
+
+// ShowContext is like Show, but takes a context.Context so the caller can
+// cancel the request or set a deadline.
+func (oc *Config) ShowContext(ctx context.Context, model string) (ShowResponse, error) {
+	reqBytes, err := json.Marshal(ShowRequest{Name: model})
+	if err != nil {
+		return ShowResponse{}, err
+	}
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/show: %s\n", oc.API, string(reqBytes))
+	}
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, oc.API+"/api/show", reqBytes)
+	if err != nil {
+		return ShowResponse{}, err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return ShowResponse{}, err
+	}
+	defer resp.Body.Close()
+	var showResp ShowResponse
+	if err := json.NewDecoder(resp.Body).Decode(&showResp); err != nil {
+		return ShowResponse{}, err
+	}
+	return showResp, nil
+}
@@ -3,6 +3,7 @@ package ollamaclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,7 +16,6 @@ import (
 
 const (
 	defaultModel       = "nous-hermes:7b-llama2-q2_K"
-	defaultPullTimeout = 48 * time.Hour   // pretty generous, in case someone has a poor connection
 	defaultHTTPTimeout = 30 * time.Second // per HTTP request to Ollama
 )
 
@@ -26,12 +26,25 @@ var (
 	}
 )
 
+// newJSONRequest builds a context-aware HTTP request with a JSON body and
+// the appropriate Content-Type header set, to avoid repeating this
+// boilerplate across every API call that POSTs JSON.
+func newJSONRequest(ctx context.Context, method, url string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
 // Config represents configuration details for communicating with the Ollama API
 type Config struct {
-	API         string
-	Model       string
-	Verbose     bool
-	PullTimeout time.Duration
+	API               string
+	Model             string
+	Verbose           bool
+	PullTimeout       time.Duration
+	MaxToolIterations int // bounds ChatWithTools' tool-call loop; defaultMaxToolIterations is used when zero
 }
 
 // GenerateRequest represents the request payload for generating output
@@ -68,26 +81,23 @@ type EmbeddingsResponse struct {
 	Embeddings []float64 `json:"embedding"`
 }
 
-// PullRequest represents the request payload for pulling a model
-type PullRequest struct {
-	Name     string `json:"name"`
-	Insecure bool   `json:"insecure,omitempty"`
-	Stream   bool   `json:"stream,omitempty"`
-}
-
-// PullResponse represents the response data from the pull API call
-type PullResponse struct {
-	Status string `json:"status"`
-	Digest string `json:"digest"`
-	Total  int64  `json:"total"`
-}
-
 // Model represents a downloaded model
 type Model struct {
-	Name     string    `json:"name"`
-	Modified time.Time `json:"modified_at"`
-	Size     int64     `json:"size"`
-	Digest   string    `json:"digest"`
+	Name     string       `json:"name"`
+	Modified time.Time    `json:"modified_at"`
+	Size     int64        `json:"size"`
+	Digest   string       `json:"digest"`
+	Details  ModelDetails `json:"details,omitempty"`
+}
+
+// ModelDetails holds the quantization and family metadata the server
+// reports for a model, as seen in /api/tags, /api/show and /api/ps
+type ModelDetails struct {
+	Format            string   `json:"format,omitempty"`
+	Family            string   `json:"family,omitempty"`
+	Families          []string `json:"families,omitempty"`
+	ParameterSize     string   `json:"parameter_size,omitempty"`
+	QuantizationLevel string   `json:"quantization_level,omitempty"`
 }
 
 // ListResponse represents the response data from the tag API call
@@ -102,6 +112,7 @@ func New() *Config {
 		env.Str("OLLAMA_MODEL", defaultModel),
 		env.Bool("OLLAMA_VERBOSE"),
 		defaultPullTimeout,
+		0,
 	}
 }
 
@@ -112,6 +123,7 @@ func NewWithModel(model string) *Config {
 		model,
 		env.Bool("OLLAMA_VERBOSE"),
 		defaultPullTimeout,
+		0,
 	}
 }
 
@@ -122,6 +134,7 @@ func NewWithAddr(addr string) *Config {
 		env.Str("OLLAMA_MODEL", defaultModel),
 		env.Bool("OLLAMA_VERBOSE"),
 		defaultPullTimeout,
+		0,
 	}
 }
 
@@ -132,6 +145,7 @@ func NewWithModelAndAddr(model, addr string) *Config {
 		model,
 		env.Bool("OLLAMA_VERBOSE"),
 		defaultPullTimeout,
+		0,
 	}
 }
 
@@ -142,11 +156,18 @@ func NewCustom(model, addr string, verbose bool, pullTimeout time.Duration) *Con
 		model,
 		verbose,
 		pullTimeout,
+		0,
 	}
 }
 
 // GetOutput sends a request to the Ollama API and returns the generated output
 func (oc *Config) GetOutput(prompt string, optionalTrimSpace ...bool) (string, error) {
+	return oc.GetOutputContext(context.Background(), prompt, optionalTrimSpace...)
+}
+
+// GetOutputContext is like GetOutput, but takes a context.Context so the
+// caller can cancel the request or set a deadline.
+func (oc *Config) GetOutputContext(ctx context.Context, prompt string, optionalTrimSpace ...bool) (string, error) {
 	reqBody := GenerateRequest{
 		Model:  oc.Model,
 		Prompt: prompt,
@@ -158,7 +179,12 @@ func (oc *Config) GetOutput(prompt string, optionalTrimSpace ...bool) (string, e
 	if oc.Verbose {
 		fmt.Printf("Sending request to %s/api/generate: %s\n", oc.API, string(reqBytes))
 	}
-	resp, err := HttpClient.Post(oc.API+"/api/generate", "application/json", bytes.NewBuffer(reqBytes))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, oc.API+"/api/generate", bytes.NewBuffer(reqBytes))
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := HttpClient.Do(httpReq)
 	if err != nil {
 		return "", err
 	}
@@ -193,6 +219,12 @@ func (oc *Config) MustOutput(prompt string) string {
 
 // AddEmbedding sends a request to get embeddings for a given prompt
 func (oc *Config) AddEmbedding(prompt string) ([]float64, error) {
+	return oc.AddEmbeddingContext(context.Background(), prompt)
+}
+
+// AddEmbeddingContext is like AddEmbedding, but takes a context.Context so
+// the caller can cancel the request or set a deadline.
+func (oc *Config) AddEmbeddingContext(ctx context.Context, prompt string) ([]float64, error) {
 	reqBody := EmbeddingsRequest{
 		Model:  oc.Model,
 		Prompt: prompt,
@@ -206,7 +238,12 @@ func (oc *Config) AddEmbedding(prompt string) ([]float64, error) {
 		fmt.Printf("Sending request to %s/api/embeddings: %s\n", oc.API, string(reqBytes))
 	}
 
-	resp, err := HttpClient.Post(oc.API+"/api/embeddings", "application/json", bytes.NewBuffer(reqBytes))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, oc.API+"/api/embeddings", bytes.NewBuffer(reqBytes))
+	if err != nil {
+		return []float64{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := HttpClient.Do(httpReq)
 	if err != nil {
 		return []float64{}, err
 	}
@@ -220,77 +257,22 @@ func (oc *Config) AddEmbedding(prompt string) ([]float64, error) {
 	return embResp.Embeddings, nil
 }
 
-// Pull sends a request to pull a specified model from the Ollama API
-func (oc *Config) Pull(optionalVerbose ...bool) (string, error) {
-	reqBody := PullRequest{
-		Name:   oc.Model,
-		Stream: true,
-	}
-	reqBytes, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", err
-	}
-	verbose := oc.Verbose
-	if len(optionalVerbose) > 0 && optionalVerbose[0] {
-		verbose = true
-	}
-	if verbose {
-		fmt.Printf("Sending request to %s/api/pull: %s\n", oc.API, string(reqBytes))
-	}
-
-	resp, err := HttpClient.Post(oc.API+"/api/pull", "application/json", bytes.NewBuffer(reqBytes))
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	var sb strings.Builder
-	decoder := json.NewDecoder(resp.Body)
-
-	if verbose {
-		fmt.Printf("Downloading and/or updating %s...", oc.Model)
-	}
-	gotUnusualStatus := false
-	start := time.Now()
-	for {
-		var pullResp PullResponse
-		if err := decoder.Decode(&pullResp); err != nil {
-			break
-		}
-		sb.WriteString(pullResp.Status)
-		if !strings.HasPrefix(pullResp.Status, "downloading ") && !strings.HasPrefix(pullResp.Status, "pulling ") {
-			if strings.HasPrefix(pullResp.Status, "verifying ") { // done downloading
-				break
-			} else if verbose {
-				if !gotUnusualStatus {
-					fmt.Println()
-				}
-				fmt.Println(pullResp.Status)
-				gotUnusualStatus = true
-			}
-			return "", fmt.Errorf("recevied status when downloading: %s", pullResp.Status)
-		}
-		if verbose && !gotUnusualStatus {
-			fmt.Print(".")
-		}
-		// Update the progress status every second
-		time.Sleep(1 * time.Second)
-		if time.Since(start) > oc.PullTimeout {
-			return sb.String(), fmt.Errorf("pull timed out after %v", oc.PullTimeout)
-		}
-	}
-	if verbose {
-		fmt.Println(" OK")
-	}
-	return sb.String(), nil
-}
-
 // List collects info about the currently downloaded models
 func (oc *Config) List() ([]string, map[string]time.Time, map[string]int64, error) {
+	return oc.ListContext(context.Background())
+}
+
+// ListContext is like List, but takes a context.Context so the caller can
+// cancel the request or set a deadline.
+func (oc *Config) ListContext(ctx context.Context) ([]string, map[string]time.Time, map[string]int64, error) {
 	if oc.Verbose {
 		fmt.Printf("Sending request to %s/api/tags\n", oc.API)
 	}
-	resp, err := http.Get(oc.API + "/api/tags")
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, oc.API+"/api/tags", nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	resp, err := HttpClient.Do(httpReq)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -311,13 +293,48 @@ func (oc *Config) List() ([]string, map[string]time.Time, map[string]int64, erro
 	return names, modifiedMap, sizeMap, nil
 }
 
+// ListModels collects the full Model structs (including Digest and Details)
+// for the currently downloaded models, unlike List which flattens them into
+// separate name/modified/size maps.
+func (oc *Config) ListModels() ([]Model, error) {
+	return oc.ListModelsContext(context.Background())
+}
+
+// ListModelsContext is like ListModels, but takes a context.Context so the
+// caller can cancel the request or set a deadline.
+func (oc *Config) ListModelsContext(ctx context.Context) ([]Model, error) {
+	if oc.Verbose {
+		fmt.Printf("Sending request to %s/api/tags\n", oc.API)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, oc.API+"/api/tags", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var listResp ListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, err
+	}
+	return listResp.Models, nil
+}
+
 // SizeOf returns the current size of the given model, or returns (-1, err) if it can't be found
 func (oc *Config) SizeOf(model string) (int64, error) {
+	return oc.SizeOfContext(context.Background(), model)
+}
+
+// SizeOfContext is like SizeOf, but takes a context.Context so the caller
+// can cancel the request or set a deadline.
+func (oc *Config) SizeOfContext(ctx context.Context, model string) (int64, error) {
 	model = strings.TrimSpace(model)
 	if !strings.Contains(model, ":") {
 		model += ":latest"
 	}
-	names, _, sizeMap, err := oc.List()
+	names, _, sizeMap, err := oc.ListContext(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -331,11 +348,17 @@ func (oc *Config) SizeOf(model string) (int64, error) {
 
 // Has returns true if the given model exists locally
 func (oc *Config) Has(model string) bool {
+	return oc.HasContext(context.Background(), model)
+}
+
+// HasContext is like Has, but takes a context.Context so the caller can
+// cancel the request or set a deadline.
+func (oc *Config) HasContext(ctx context.Context, model string) bool {
 	model = strings.TrimSpace(model)
 	if !strings.Contains(model, ":") {
 		model += ":latest"
 	}
-	if names, _, _, err := oc.List(); err == nil { // success
+	if names, _, _, err := oc.ListContext(ctx); err == nil { // success
 		for _, name := range names {
 			if name == model {
 				return true
@@ -0,0 +1,184 @@
+package ollamaclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// OpenAIConfig wraps a Config to talk to Ollama's OpenAI-compatible surface
+// at /v1/chat/completions instead of the native /api/chat, for callers who
+// already have code written against the OpenAI wire format.
+type OpenAIConfig struct {
+	*Config
+}
+
+// OpenAI returns a view of this Config that speaks the OpenAI-compatible
+// API, sharing the same HttpClient, API address and model.
+func (oc *Config) OpenAI() *OpenAIConfig {
+	return &OpenAIConfig{oc}
+}
+
+// OpenAIMessage represents a single message in the OpenAI chat format
+type OpenAIMessage struct {
+	Role      string           `json:"role"`
+	Content   string           `json:"content"`
+	ToolCalls []OpenAIToolCall `json:"tool_calls,omitempty"`
+}
+
+// OpenAIToolCall represents a single tool call in the OpenAI chat format
+type OpenAIToolCall struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+// OpenAIChatCompletionRequest represents the request payload for
+// /v1/chat/completions
+type OpenAIChatCompletionRequest struct {
+	Model    string          `json:"model"`
+	Messages []OpenAIMessage `json:"messages"`
+	Tools    json.RawMessage `json:"tools,omitempty"`
+	Stream   bool            `json:"stream,omitempty"`
+}
+
+// OpenAIChoice represents a single completion choice
+type OpenAIChoice struct {
+	Index        int           `json:"index"`
+	Message      OpenAIMessage `json:"message"`
+	FinishReason string        `json:"finish_reason"`
+}
+
+// OpenAIUsage represents token usage accounting for a completion
+type OpenAIUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// OpenAIChatCompletionResponse represents the response data from a
+// non-streaming call to /v1/chat/completions
+type OpenAIChatCompletionResponse struct {
+	ID      string         `json:"id"`
+	Object  string         `json:"object"`
+	Created int64          `json:"created"`
+	Model   string         `json:"model"`
+	Choices []OpenAIChoice `json:"choices"`
+	Usage   OpenAIUsage    `json:"usage"`
+}
+
+// OpenAIDelta represents the incremental content of a single streamed chunk
+type OpenAIDelta struct {
+	Role      string           `json:"role,omitempty"`
+	Content   string           `json:"content,omitempty"`
+	ToolCalls []OpenAIToolCall `json:"tool_calls,omitempty"`
+}
+
+// OpenAIChunkChoice represents a single choice within a streamed chunk
+type OpenAIChunkChoice struct {
+	Index        int         `json:"index"`
+	Delta        OpenAIDelta `json:"delta"`
+	FinishReason *string     `json:"finish_reason"`
+}
+
+// OpenAIChatCompletionChunk represents a single "data: ..." SSE frame from a
+// streaming call to /v1/chat/completions
+type OpenAIChatCompletionChunk struct {
+	ID      string              `json:"id"`
+	Object  string              `json:"object"`
+	Created int64               `json:"created"`
+	Model   string              `json:"model"`
+	Choices []OpenAIChunkChoice `json:"choices"`
+}
+
+// ChatCompletion sends a non-streaming request to /v1/chat/completions and
+// returns the full response.
+func (o *OpenAIConfig) ChatCompletion(ctx context.Context, req OpenAIChatCompletionRequest) (OpenAIChatCompletionResponse, error) {
+	if req.Model == "" {
+		req.Model = o.Model
+	}
+	req.Stream = false
+
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return OpenAIChatCompletionResponse{}, err
+	}
+	if o.Verbose {
+		fmt.Printf("Sending request to %s/v1/chat/completions: %s\n", o.API, string(reqBytes))
+	}
+
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, o.API+"/v1/chat/completions", reqBytes)
+	if err != nil {
+		return OpenAIChatCompletionResponse{}, err
+	}
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return OpenAIChatCompletionResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	var completion OpenAIChatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return OpenAIChatCompletionResponse{}, err
+	}
+	return completion, nil
+}
+
+// ChatCompletionStream sends a streaming request to /v1/chat/completions
+// and invokes fn once per "data: ..." SSE frame, stopping cleanly at the
+// "data: [DONE]" terminator. It honors ctx cancellation so callers can
+// abort mid-stream.
+func (o *OpenAIConfig) ChatCompletionStream(ctx context.Context, req OpenAIChatCompletionRequest, fn func(OpenAIChatCompletionChunk) error) error {
+	if req.Model == "" {
+		req.Model = o.Model
+	}
+	req.Stream = true
+
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if o.Verbose {
+		fmt.Printf("Sending request to %s/v1/chat/completions: %s\n", o.API, string(reqBytes))
+	}
+
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, o.API+"/v1/chat/completions", reqBytes)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Accept", "text/event-stream")
+	resp, err := HttpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if payload == "[DONE]" {
+			return nil
+		}
+		var chunk OpenAIChatCompletionChunk
+		if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+			return err
+		}
+		if err := fn(chunk); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -1,7 +1,7 @@
 package ollamaclient
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,31 +14,31 @@ import (
 
 const defaultPullTimeout = 48 * time.Hour // pretty generous, in case someone has a poor connection
 
+// PullRequest represents the request payload for pulling a model
 type PullRequest struct {
-	Name   string
-	Stream bool
+	Name     string `json:"name"`
+	Insecure bool   `json:"insecure,omitempty"`
+	Stream   bool   `json:"stream,omitempty"`
 }
 
+// PullResponse represents the response data from the pull API call
 type PullResponse struct {
+	Status    string `json:"status"`
+	Digest    string `json:"digest"`
+	Total     int64  `json:"total"`
+	Completed int64  `json:"completed"`
+}
+
+// PullEvent is a typed progress update emitted by PullStream, derived from
+// the raw NDJSON status line the server sends
+type PullEvent struct {
+	Phase     string // "manifest", "downloading", "verifying" or "success"
 	Digest    string
 	Completed int64
 	Total     int64
-	Status    string
+	Status    string // the raw status string from the server
 }
 
-var (
-	spinner = []string{"-", "\\", "|", "/"}
-	colors  = map[string]string{
-		"blue":    "\033[94m",
-		"cyan":    "\033[96m",
-		"gray":    "\033[37m",
-		"magenta": "\033[95m",
-		"red":     "\033[91m",
-		"white":   "\033[97m",
-		"reset":   "\033[0m",
-	}
-)
-
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -46,103 +46,177 @@ func max(a, b int) int {
 	return b
 }
 
-func generateColorizedProgressBar(progress float64, width int) string {
-	progressInt := int(progress / 100 * float64(width))
-	bar := colors["blue"] + strings.Repeat("=", progressInt)
-	if progressInt > width/3 {
-		bar += colors["magenta"] + strings.Repeat("=", max(0, progressInt-width/3))
-	}
-	if progressInt > 2*width/3 {
-		bar += colors["cyan"] + strings.Repeat("=", max(0, progressInt-2*width/3))
-	}
-	bar += colors["white"] + strings.Repeat(" ", width-max(progressInt, width)) + colors["reset"]
-	return bar
+// Pull sends a request to pull the configured model and renders its progress
+// to the terminal. It is a thin wrapper around PullStream using a
+// TerminalProgressReporter, kept for backward compatibility.
+func (oc *Config) Pull(optionalVerbose ...bool) (string, error) {
+	return oc.PullContext(context.Background(), optionalVerbose...)
 }
 
-func (oc *Config) Pull(optionalVerbose ...bool) (string, error) {
-	if env.Bool("NO_COLOR") {
-		// Skip colors
-		for k := range colors {
-			colors[k] = ""
-		}
-	}
+// PullContext is like Pull, but takes a context.Context so the caller can
+// cancel a long-running download or set a deadline.
+func (oc *Config) PullContext(ctx context.Context, optionalVerbose ...bool) (string, error) {
 	verbose := oc.Verbose
 	if len(optionalVerbose) > 0 && optionalVerbose[0] {
 		verbose = true
 	}
 
-	reqBody := PullRequest{
-		Name:   oc.Model,
-		Stream: true,
-	}
-	reqBytes, err := json.Marshal(reqBody)
+	reporter := NewTerminalProgressReporter(oc.Model, verbose)
+	var sb strings.Builder
+	err := oc.PullStream(ctx, PullRequest{Name: oc.Model, Stream: true}, func(event PullEvent) error {
+		sb.WriteString(event.Status)
+		return reporter.Report(event)
+	})
+	return sb.String(), err
+}
+
+// PullStream sends a pull request and invokes fn once per streamed NDJSON
+// status update, as a typed PullEvent. It honors ctx cancellation and
+// oc.PullTimeout, and renders no progress of its own (it only prints the
+// outgoing request when oc.Verbose is set) — pair it with a
+// TerminalProgressReporter (or any other func(PullEvent) error) to render
+// progress.
+func (oc *Config) PullStream(ctx context.Context, req PullRequest, fn func(PullEvent) error) error {
+	req.Stream = true
+	reqBytes, err := json.Marshal(req)
 	if err != nil {
-		return "", err
+		return err
 	}
-	if verbose {
+	if oc.Verbose {
 		fmt.Printf("Sending request to %s/api/pull: %s\n", oc.API, string(reqBytes))
 	}
 
-	resp, err := http.Post(oc.API+"/api/pull", "application/json", bytes.NewBuffer(reqBytes))
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, oc.API+"/api/pull", reqBytes)
+	if err != nil {
+		return err
+	}
+	resp, err := HttpClient.Do(httpReq)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer resp.Body.Close()
 
-	var sb strings.Builder
 	decoder := json.NewDecoder(resp.Body)
-
 	downloadStarted := time.Now()
-	spinnerPosition := 0
-	var lastDigest string // Track the last hash
-
-OUT:
 	for {
-		var resp PullResponse
-		if err := decoder.Decode(&resp); err != nil {
-			return sb.String(), err
+		var status PullResponse
+		if err := decoder.Decode(&status); err != nil {
+			return err
 		}
 
-		shortDigest := strings.TrimPrefix(resp.Digest, "sha256:")
-		if len(shortDigest) > 8 {
-			shortDigest = shortDigest[:8]
+		if err := fn(PullEvent{
+			Phase:     pullPhase(status),
+			Digest:    status.Digest,
+			Completed: status.Completed,
+			Total:     status.Total,
+			Status:    status.Status,
+		}); err != nil {
+			return err
 		}
 
-		// Check if the hash has changed (indicating a new part of the download)
-		if lastDigest != "" && lastDigest != resp.Digest {
-			if verbose {
-				fmt.Println() // Insert a newline for a new part
-			}
+		if status.Status == "success" {
+			return nil
 		}
-		lastDigest = resp.Digest // Update the lastDigest for the next loop
-
-		if resp.Total == 0 {
-			if verbose {
-				fmt.Printf("\r%sPulling manifest... %s%s", colors["white"], spinner[spinnerPosition%len(spinner)], colors["reset"])
-				spinnerPosition++
-			}
-		} else {
-			progress := float64(resp.Completed) / float64(resp.Total) * 100
-			progressBar := generateColorizedProgressBar(progress, 30) // Fixed width bar
-			displaySizeCompleted := humanize.Bytes(uint64(resp.Completed))
-			displaySizeTotal := humanize.Bytes(uint64(resp.Total))
-
-			if verbose {
-				fmt.Printf("\r%s%s - %s [%s] %.2f%% - %s/%s %s", colors["white"], oc.Model, shortDigest, progressBar, progress, displaySizeCompleted, displaySizeTotal, colors["reset"])
-			}
+		if err := ctx.Err(); err != nil {
+			return err
 		}
-
-		if resp.Status == "success" {
-			if verbose {
-				fmt.Printf("\r%s - Download complete!\033[K\n", oc.Model)
-			}
-			break OUT
+		if time.Since(downloadStarted) > oc.PullTimeout {
+			return fmt.Errorf("downloading %s timed out after %v", oc.Model, oc.PullTimeout)
 		}
+	}
+}
+
+// pullPhase classifies a raw PullResponse status line into a PullEvent.Phase
+func pullPhase(status PullResponse) string {
+	switch {
+	case status.Status == "success":
+		return "success"
+	case strings.HasPrefix(status.Status, "verifying "):
+		return "verifying"
+	case status.Total == 0:
+		return "manifest"
+	default:
+		return "downloading"
+	}
+}
 
-		if time.Since(downloadStarted) > defaultPullTimeout {
-			return sb.String(), fmt.Errorf("downloading %s timed out after %v", oc.Model, defaultPullTimeout)
+// TerminalProgressReporter renders PullEvent updates as a colorized,
+// spinner-and-progress-bar status line, the way Pull used to render them
+// inline. Use it as the callback passed to PullStream.
+type TerminalProgressReporter struct {
+	Model   string
+	Verbose bool
+
+	spinnerPosition int
+	lastDigest      string
+	colors          map[string]string
+}
+
+// NewTerminalProgressReporter creates a TerminalProgressReporter for the
+// given model name. Output is suppressed entirely unless verbose is true.
+func NewTerminalProgressReporter(model string, verbose bool) *TerminalProgressReporter {
+	colors := map[string]string{
+		"blue":    "\033[94m",
+		"cyan":    "\033[96m",
+		"gray":    "\033[37m",
+		"magenta": "\033[95m",
+		"red":     "\033[91m",
+		"white":   "\033[97m",
+		"reset":   "\033[0m",
+	}
+	if env.Bool("NO_COLOR") {
+		for k := range colors {
+			colors[k] = ""
 		}
 	}
+	return &TerminalProgressReporter{Model: model, Verbose: verbose, colors: colors}
+}
 
-	return sb.String(), nil
+// Report renders a single PullEvent to the terminal. It implements the
+// func(PullEvent) error signature expected by PullStream.
+func (r *TerminalProgressReporter) Report(event PullEvent) error {
+	if !r.Verbose {
+		return nil
+	}
+
+	spinner := []string{"-", "\\", "|", "/"}
+
+	shortDigest := strings.TrimPrefix(event.Digest, "sha256:")
+	if len(shortDigest) > 8 {
+		shortDigest = shortDigest[:8]
+	}
+
+	// Check if the hash has changed (indicating a new part of the download)
+	if r.lastDigest != "" && r.lastDigest != event.Digest {
+		fmt.Println() // Insert a newline for a new part
+	}
+	r.lastDigest = event.Digest
+
+	switch event.Phase {
+	case "manifest":
+		fmt.Printf("\r%sPulling manifest... %s%s", r.colors["white"], spinner[r.spinnerPosition%len(spinner)], r.colors["reset"])
+		r.spinnerPosition++
+	case "success":
+		fmt.Printf("\r%s - Download complete!\033[K\n", r.Model)
+	default:
+		progress := float64(event.Completed) / float64(event.Total) * 100
+		progressBar := generateColorizedProgressBar(progress, 30, r.colors) // Fixed width bar
+		displaySizeCompleted := humanize.Bytes(uint64(event.Completed))
+		displaySizeTotal := humanize.Bytes(uint64(event.Total))
+		fmt.Printf("\r%s%s - %s [%s] %.2f%% - %s/%s %s", r.colors["white"], r.Model, shortDigest, progressBar, progress, displaySizeCompleted, displaySizeTotal, r.colors["reset"])
+	}
+	return nil
+}
+
+func generateColorizedProgressBar(progress float64, width int, colors map[string]string) string {
+	progressInt := int(progress / 100 * float64(width))
+	bar := colors["blue"] + strings.Repeat("=", progressInt)
+	if progressInt > width/3 {
+		bar += colors["magenta"] + strings.Repeat("=", max(0, progressInt-width/3))
+	}
+	if progressInt > 2*width/3 {
+		bar += colors["cyan"] + strings.Repeat("=", max(0, progressInt-2*width/3))
+	}
+	bar += colors["white"] + strings.Repeat(" ", width-max(progressInt, width)) + colors["reset"]
+	return bar
 }
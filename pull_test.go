@@ -0,0 +1,95 @@
+package ollamaclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ndjsonHandler serves the given PullResponse lines as a newline-delimited
+// JSON stream, one write per line, mimicking how Ollama streams /api/pull.
+func ndjsonHandler(lines []PullResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, line := range lines {
+			fmt.Fprintf(w, `{"status":%q,"digest":%q,"total":%d,"completed":%d}`+"\n",
+				line.Status, line.Digest, line.Total, line.Completed)
+		}
+	}
+}
+
+func TestPullStreamReportsPhasesInOrder(t *testing.T) {
+	lines := []PullResponse{
+		{Status: "pulling manifest", Total: 0},
+		{Status: "downloading sha256:abc123", Digest: "sha256:abc123", Total: 100, Completed: 10},
+		{Status: "downloading sha256:abc123", Digest: "sha256:abc123", Total: 100, Completed: 100},
+		{Status: "verifying sha256 digest", Digest: "sha256:abc123", Total: 100, Completed: 100},
+		{Status: "success"},
+	}
+	server := httptest.NewServer(ndjsonHandler(lines))
+	defer server.Close()
+
+	oc := &Config{API: server.URL, Model: "testmodel", PullTimeout: time.Second}
+
+	var phases []string
+	var maxCompleted int64
+	err := oc.PullStream(context.Background(), PullRequest{Name: oc.Model}, func(event PullEvent) error {
+		phases = append(phases, event.Phase)
+		if event.Completed > maxCompleted {
+			maxCompleted = event.Completed
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("PullStream returned an error: %v", err)
+	}
+
+	wantPhases := []string{"manifest", "downloading", "downloading", "verifying", "success"}
+	if strings.Join(phases, ",") != strings.Join(wantPhases, ",") {
+		t.Fatalf("phases = %v, want %v", phases, wantPhases)
+	}
+	if maxCompleted != 100 {
+		t.Fatalf("max Completed seen = %d, want 100", maxCompleted)
+	}
+}
+
+func TestPullStreamTimesOut(t *testing.T) {
+	lines := []PullResponse{
+		{Status: "pulling manifest", Total: 0},
+		{Status: "downloading sha256:abc123", Digest: "sha256:abc123", Total: 100, Completed: 10},
+	}
+	server := httptest.NewServer(ndjsonHandler(lines))
+	defer server.Close()
+
+	oc := &Config{API: server.URL, Model: "testmodel", PullTimeout: 1 * time.Nanosecond}
+
+	err := oc.PullStream(context.Background(), PullRequest{Name: oc.Model}, func(event PullEvent) error {
+		time.Sleep(time.Millisecond) // make sure the timeout has elapsed by the next check
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestPullUsesTerminalProgressReporter(t *testing.T) {
+	lines := []PullResponse{
+		{Status: "pulling manifest", Total: 0},
+		{Status: "success"},
+	}
+	server := httptest.NewServer(ndjsonHandler(lines))
+	defer server.Close()
+
+	oc := &Config{API: server.URL, Model: "testmodel", PullTimeout: time.Second}
+
+	status, err := oc.Pull(false)
+	if err != nil {
+		t.Fatalf("Pull returned an error: %v", err)
+	}
+	if status != "pulling manifestsuccess" {
+		t.Fatalf("status = %q, want %q", status, "pulling manifestsuccess")
+	}
+}
@@ -0,0 +1,205 @@
+package ollamaclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// defaultMaxToolIterations bounds how many tool-call round trips ChatWithTools
+// will make before giving up, in case the model keeps requesting tools.
+const defaultMaxToolIterations = 8
+
+// Tool describes a Go-side function the model may call during a chat
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  json.RawMessage // JSON Schema describing the tool's arguments
+	Handler     ToolHandler
+}
+
+// ToolHandler is called with the arguments the model supplied for a tool call
+// and returns the result that will be sent back to the model as a "tool" message
+type ToolHandler func(args json.RawMessage) (string, error)
+
+// toolCall mirrors the shape of a single entry in a streamed assistant
+// message's "tool_calls" array
+type toolCall struct {
+	Function struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	} `json:"function"`
+}
+
+// toolChatMessage extends ChatMessage with the tool_calls field used
+// internally to detect and dispatch tool invocations
+type toolChatMessage struct {
+	ChatMessage
+	ToolCalls []toolCall `json:"tool_calls,omitempty"`
+}
+
+// toolSpec is the wire format Ollama expects for a single entry in "tools"
+type toolSpec struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name        string          `json:"name"`
+		Description string          `json:"description"`
+		Parameters  json.RawMessage `json:"parameters"`
+	} `json:"function"`
+}
+
+// ChatWithTools sends messages along with the given tools, dispatches any
+// tool calls the model requests to the matching Tool.Handler, appends the
+// results as "tool" messages and repeats until the model replies without
+// requesting a tool (or oc.MaxToolIterations is reached; defaultMaxToolIterations
+// is used when oc.MaxToolIterations is zero).
+func (oc *Config) ChatWithTools(ctx context.Context, messages []ChatMessage, tools []Tool) (ChatResponse, error) {
+	maxIterations := oc.MaxToolIterations
+	if maxIterations <= 0 {
+		maxIterations = defaultMaxToolIterations
+	}
+
+	handlers := make(map[string]ToolHandler, len(tools))
+	specs := make([]toolSpec, len(tools))
+	for i, tool := range tools {
+		handlers[tool.Name] = tool.Handler
+		specs[i].Type = "function"
+		specs[i].Function.Name = tool.Name
+		specs[i].Function.Description = tool.Description
+		specs[i].Function.Parameters = tool.Parameters
+	}
+	specBytes, err := json.Marshal(specs)
+	if err != nil {
+		return ChatResponse{}, err
+	}
+
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		reqBytes, err := json.Marshal(ChatRequest{
+			Model:    oc.Model,
+			Messages: messages,
+			Tools:    specBytes,
+			Stream:   true,
+		})
+		if err != nil {
+			return ChatResponse{}, err
+		}
+
+		var raw toolChatMessage
+		var final ChatResponse
+		var content []byte
+		err = oc.chatRaw(ctx, reqBytes, func(chunk chatRawResponse) error {
+			content = append(content, chunk.Message.Content...)
+			if len(chunk.Message.ToolCalls) > 0 {
+				raw.ToolCalls = chunk.Message.ToolCalls
+			}
+			final = ChatResponse{
+				Model:              chunk.Model,
+				CreatedAt:          chunk.CreatedAt,
+				Done:               chunk.Done,
+				TotalDuration:      chunk.TotalDuration,
+				LoadDuration:       chunk.LoadDuration,
+				PromptEvalCount:    chunk.PromptEvalCount,
+				PromptEvalDuration: chunk.PromptEvalDuration,
+				EvalCount:          chunk.EvalCount,
+				EvalDuration:       chunk.EvalDuration,
+			}
+			return nil
+		})
+		if err != nil {
+			return ChatResponse{}, err
+		}
+		final.Message.Content = string(content)
+
+		if len(raw.ToolCalls) == 0 {
+			return final, nil
+		}
+
+		messages = append(messages, ChatMessage{Role: "assistant", Content: final.Message.Content})
+		for _, call := range raw.ToolCalls {
+			handler, ok := handlers[call.Function.Name]
+			if !ok {
+				return ChatResponse{}, fmt.Errorf("model requested unknown tool: %s", call.Function.Name)
+			}
+			result, err := handler(call.Function.Arguments)
+			if err != nil {
+				result = "error: " + err.Error()
+			}
+			messages = append(messages, ChatMessage{Role: "tool", Content: result})
+		}
+	}
+	return ChatResponse{}, fmt.Errorf("exceeded max tool iterations (%d)", maxIterations)
+}
+
+// SchemaFromStruct derives a JSON Schema object for the given struct value,
+// so callers don't have to hand-write Tool.Parameters. Field names are
+// lower-cased unless a `json` tag says otherwise.
+func SchemaFromStruct(v any) (json.RawMessage, error) {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("SchemaFromStruct: expected a struct, got %s", t.Kind())
+	}
+
+	properties := make(map[string]any)
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.ToLower(field.Name)
+		omitempty := false
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				if opt == "omitempty" {
+					omitempty = true
+				}
+			}
+		}
+		properties[name] = map[string]any{"type": jsonSchemaType(field.Type)}
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return json.Marshal(schema)
+}
+
+// jsonSchemaType maps a Go kind to the closest JSON Schema primitive type
+func jsonSchemaType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return "string"
+	}
+}